@@ -0,0 +1,190 @@
+/*
+ *
+ * Copyright 2022 gRPC authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package reflection
+
+import (
+	v1pb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	v1alphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// v1ServerAdapter exposes a grpc_reflection_v1alpha.ServerReflectionServer
+// implementation as a grpc_reflection_v1.ServerReflectionServer, so both
+// protocols can be backed by a single implementation.
+type v1ServerAdapter struct {
+	svr v1alphapb.ServerReflectionServer
+}
+
+func (a *v1ServerAdapter) ServerReflectionInfo(stream v1pb.ServerReflection_ServerReflectionInfoServer) error {
+	return a.svr.ServerReflectionInfo(&v1AlphaServerStreamAdapter{stream})
+}
+
+// v1AlphaServerStreamAdapter adapts a v1 server stream to the v1alpha
+// server stream interface, translating each message as it crosses the
+// boundary.
+type v1AlphaServerStreamAdapter struct {
+	v1pb.ServerReflection_ServerReflectionInfoServer
+}
+
+func (a *v1AlphaServerStreamAdapter) Send(resp *v1alphapb.ServerReflectionResponse) error {
+	return a.ServerReflection_ServerReflectionInfoServer.Send(V1AlphaResponseToV1(resp))
+}
+
+func (a *v1AlphaServerStreamAdapter) Recv() (*v1alphapb.ServerReflectionRequest, error) {
+	req, err := a.ServerReflection_ServerReflectionInfoServer.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return V1RequestToV1Alpha(req), nil
+}
+
+// V1AlphaRequestToV1 converts a v1alpha ServerReflectionRequest to its v1
+// equivalent, field for field.
+func V1AlphaRequestToV1(req *v1alphapb.ServerReflectionRequest) *v1pb.ServerReflectionRequest {
+	if req == nil {
+		return nil
+	}
+	out := &v1pb.ServerReflectionRequest{Host: req.Host}
+	switch r := req.MessageRequest.(type) {
+	case *v1alphapb.ServerReflectionRequest_FileByFilename:
+		out.MessageRequest = &v1pb.ServerReflectionRequest_FileByFilename{FileByFilename: r.FileByFilename}
+	case *v1alphapb.ServerReflectionRequest_FileContainingSymbol:
+		out.MessageRequest = &v1pb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: r.FileContainingSymbol}
+	case *v1alphapb.ServerReflectionRequest_FileContainingExtension:
+		out.MessageRequest = &v1pb.ServerReflectionRequest_FileContainingExtension{
+			FileContainingExtension: &v1pb.ExtensionRequest{
+				ContainingType:  r.FileContainingExtension.GetContainingType(),
+				ExtensionNumber: r.FileContainingExtension.GetExtensionNumber(),
+			},
+		}
+	case *v1alphapb.ServerReflectionRequest_AllExtensionNumbersOfType:
+		out.MessageRequest = &v1pb.ServerReflectionRequest_AllExtensionNumbersOfType{AllExtensionNumbersOfType: r.AllExtensionNumbersOfType}
+	case *v1alphapb.ServerReflectionRequest_ListServices:
+		out.MessageRequest = &v1pb.ServerReflectionRequest_ListServices{ListServices: r.ListServices}
+	}
+	return out
+}
+
+// V1RequestToV1Alpha converts a v1 ServerReflectionRequest to its v1alpha
+// equivalent, field for field.
+func V1RequestToV1Alpha(req *v1pb.ServerReflectionRequest) *v1alphapb.ServerReflectionRequest {
+	if req == nil {
+		return nil
+	}
+	out := &v1alphapb.ServerReflectionRequest{Host: req.Host}
+	switch r := req.MessageRequest.(type) {
+	case *v1pb.ServerReflectionRequest_FileByFilename:
+		out.MessageRequest = &v1alphapb.ServerReflectionRequest_FileByFilename{FileByFilename: r.FileByFilename}
+	case *v1pb.ServerReflectionRequest_FileContainingSymbol:
+		out.MessageRequest = &v1alphapb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: r.FileContainingSymbol}
+	case *v1pb.ServerReflectionRequest_FileContainingExtension:
+		out.MessageRequest = &v1alphapb.ServerReflectionRequest_FileContainingExtension{
+			FileContainingExtension: &v1alphapb.ExtensionRequest{
+				ContainingType:  r.FileContainingExtension.GetContainingType(),
+				ExtensionNumber: r.FileContainingExtension.GetExtensionNumber(),
+			},
+		}
+	case *v1pb.ServerReflectionRequest_AllExtensionNumbersOfType:
+		out.MessageRequest = &v1alphapb.ServerReflectionRequest_AllExtensionNumbersOfType{AllExtensionNumbersOfType: r.AllExtensionNumbersOfType}
+	case *v1pb.ServerReflectionRequest_ListServices:
+		out.MessageRequest = &v1alphapb.ServerReflectionRequest_ListServices{ListServices: r.ListServices}
+	}
+	return out
+}
+
+// V1AlphaResponseToV1 converts a v1alpha ServerReflectionResponse to its v1
+// equivalent, field for field.
+func V1AlphaResponseToV1(resp *v1alphapb.ServerReflectionResponse) *v1pb.ServerReflectionResponse {
+	if resp == nil {
+		return nil
+	}
+	out := &v1pb.ServerReflectionResponse{
+		ValidHost:       resp.ValidHost,
+		OriginalRequest: V1AlphaRequestToV1(resp.OriginalRequest),
+	}
+	switch r := resp.MessageResponse.(type) {
+	case *v1alphapb.ServerReflectionResponse_FileDescriptorResponse:
+		out.MessageResponse = &v1pb.ServerReflectionResponse_FileDescriptorResponse{
+			FileDescriptorResponse: &v1pb.FileDescriptorResponse{FileDescriptorProto: r.FileDescriptorResponse.GetFileDescriptorProto()},
+		}
+	case *v1alphapb.ServerReflectionResponse_AllExtensionNumbersResponse:
+		out.MessageResponse = &v1pb.ServerReflectionResponse_AllExtensionNumbersResponse{
+			AllExtensionNumbersResponse: &v1pb.ExtensionNumberResponse{
+				BaseTypeName:    r.AllExtensionNumbersResponse.GetBaseTypeName(),
+				ExtensionNumber: r.AllExtensionNumbersResponse.GetExtensionNumber(),
+			},
+		}
+	case *v1alphapb.ServerReflectionResponse_ListServicesResponse:
+		services := make([]*v1pb.ServiceResponse, 0, len(r.ListServicesResponse.GetService()))
+		for _, svc := range r.ListServicesResponse.GetService() {
+			services = append(services, &v1pb.ServiceResponse{Name: svc.GetName()})
+		}
+		out.MessageResponse = &v1pb.ServerReflectionResponse_ListServicesResponse{
+			ListServicesResponse: &v1pb.ListServiceResponse{Service: services},
+		}
+	case *v1alphapb.ServerReflectionResponse_ErrorResponse:
+		out.MessageResponse = &v1pb.ServerReflectionResponse_ErrorResponse{
+			ErrorResponse: &v1pb.ErrorResponse{
+				ErrorCode:    r.ErrorResponse.GetErrorCode(),
+				ErrorMessage: r.ErrorResponse.GetErrorMessage(),
+			},
+		}
+	}
+	return out
+}
+
+// V1ResponseToV1Alpha converts a v1 ServerReflectionResponse to its
+// v1alpha equivalent, field for field.
+func V1ResponseToV1Alpha(resp *v1pb.ServerReflectionResponse) *v1alphapb.ServerReflectionResponse {
+	if resp == nil {
+		return nil
+	}
+	out := &v1alphapb.ServerReflectionResponse{
+		ValidHost:       resp.ValidHost,
+		OriginalRequest: V1RequestToV1Alpha(resp.OriginalRequest),
+	}
+	switch r := resp.MessageResponse.(type) {
+	case *v1pb.ServerReflectionResponse_FileDescriptorResponse:
+		out.MessageResponse = &v1alphapb.ServerReflectionResponse_FileDescriptorResponse{
+			FileDescriptorResponse: &v1alphapb.FileDescriptorResponse{FileDescriptorProto: r.FileDescriptorResponse.GetFileDescriptorProto()},
+		}
+	case *v1pb.ServerReflectionResponse_AllExtensionNumbersResponse:
+		out.MessageResponse = &v1alphapb.ServerReflectionResponse_AllExtensionNumbersResponse{
+			AllExtensionNumbersResponse: &v1alphapb.ExtensionNumberResponse{
+				BaseTypeName:    r.AllExtensionNumbersResponse.GetBaseTypeName(),
+				ExtensionNumber: r.AllExtensionNumbersResponse.GetExtensionNumber(),
+			},
+		}
+	case *v1pb.ServerReflectionResponse_ListServicesResponse:
+		services := make([]*v1alphapb.ServiceResponse, 0, len(r.ListServicesResponse.GetService()))
+		for _, svc := range r.ListServicesResponse.GetService() {
+			services = append(services, &v1alphapb.ServiceResponse{Name: svc.GetName()})
+		}
+		out.MessageResponse = &v1alphapb.ServerReflectionResponse_ListServicesResponse{
+			ListServicesResponse: &v1alphapb.ListServiceResponse{Service: services},
+		}
+	case *v1pb.ServerReflectionResponse_ErrorResponse:
+		out.MessageResponse = &v1alphapb.ServerReflectionResponse_ErrorResponse{
+			ErrorResponse: &v1alphapb.ErrorResponse{
+				ErrorCode:    r.ErrorResponse.GetErrorCode(),
+				ErrorMessage: r.ErrorResponse.GetErrorMessage(),
+			},
+		}
+	}
+	return out
+}
@@ -0,0 +1,307 @@
+/*
+ *
+ * Copyright 2022 gRPC authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package client provides a high-level client for the gRPC server
+// reflection service implemented by the reflection package.
+package client
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Client is a high-level client for the server reflection service. A
+// Client multiplexes every request over a single bidirectional stream, so
+// it is not safe for concurrent use by multiple goroutines that expect
+// their own independent ordering, but it is safe to call its methods
+// concurrently.
+type Client struct {
+	// mu serializes access to stream: reflection requests and responses
+	// are correlated purely by being sent and received in lockstep on the
+	// same stream.
+	mu     sync.Mutex
+	stream rpb.ServerReflection_ServerReflectionInfoClient
+
+	filesMu sync.Mutex
+	files   map[string]*descriptorpb.FileDescriptorProto
+}
+
+// NewClient opens a server reflection stream over cc and returns a Client
+// using it. The stream, and therefore the Client, is only valid for the
+// lifetime of ctx.
+func NewClient(ctx context.Context, cc *grpc.ClientConn) (*Client, error) {
+	stream, err := rpb.NewServerReflectionClient(cc).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		stream: stream,
+		files:  make(map[string]*descriptorpb.FileDescriptorProto),
+	}, nil
+}
+
+// Close closes the underlying reflection stream.
+func (c *Client) Close() error {
+	return c.stream.CloseSend()
+}
+
+// send issues req on the stream and returns the matching response,
+// translating an ErrorResponse into a Go error carrying the original
+// grpc/codes code.
+func (c *Client) send(req *rpb.ServerReflectionRequest) (*rpb.ServerReflectionResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.stream.Send(req); err != nil {
+		return nil, err
+	}
+	resp, err := c.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if e := resp.GetErrorResponse(); e != nil {
+		return nil, status.Error(codes.Code(e.ErrorCode), e.ErrorMessage)
+	}
+	return resp, nil
+}
+
+// cachedFile returns the previously resolved descriptor for name, or nil
+// if it has not been fetched yet.
+func (c *Client) cachedFile(name string) *descriptorpb.FileDescriptorProto {
+	c.filesMu.Lock()
+	defer c.filesMu.Unlock()
+	return c.files[name]
+}
+
+// cacheFile records fd and transitively fetches and caches every file it
+// depends on, so that a single FileByFilename or FileContainingSymbol call
+// is enough to make the rest of its dependency closure available from the
+// cache for filesAsRegistry.
+func (c *Client) cacheFile(fd *descriptorpb.FileDescriptorProto) error {
+	c.filesMu.Lock()
+	_, cached := c.files[fd.GetName()]
+	if !cached {
+		c.files[fd.GetName()] = fd
+	}
+	c.filesMu.Unlock()
+	if cached {
+		return nil
+	}
+
+	for _, dep := range fd.GetDependency() {
+		if c.cachedFile(dep) != nil {
+			continue
+		}
+		if _, err := c.FileByFilename(dep); err != nil {
+			return fmt.Errorf("reflection: resolving dependency %q of %q: %v", dep, fd.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// sendAndCacheFile issues req, which must be a request answered with a
+// FileDescriptorResponse, decodes and caches every descriptor it returns,
+// and returns the first one.
+func (c *Client) sendAndCacheFile(req *rpb.ServerReflectionRequest) (*descriptorpb.FileDescriptorProto, error) {
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	fdr := resp.GetFileDescriptorResponse()
+	if fdr == nil {
+		return nil, fmt.Errorf("reflection: unexpected response type %T", resp.MessageResponse)
+	}
+
+	var first *descriptorpb.FileDescriptorProto
+	for i, raw := range fdr.FileDescriptorProto {
+		fd := new(descriptorpb.FileDescriptorProto)
+		if err := proto.Unmarshal(raw, fd); err != nil {
+			return nil, fmt.Errorf("reflection: decoding file descriptor: %v", err)
+		}
+		if i == 0 {
+			first = fd
+		}
+		if err := c.cacheFile(fd); err != nil {
+			return nil, err
+		}
+	}
+	return first, nil
+}
+
+// FileByFilename returns the descriptor for the file with the given name,
+// resolving its transitive dependencies and caching all of them along the
+// way.
+func (c *Client) FileByFilename(name string) (*descriptorpb.FileDescriptorProto, error) {
+	if fd := c.cachedFile(name); fd != nil {
+		return fd, nil
+	}
+	return c.sendAndCacheFile(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileByFilename{FileByFilename: name},
+	})
+}
+
+// FileContainingSymbol returns the descriptor for the file declaring the
+// given fully-qualified symbol.
+func (c *Client) FileContainingSymbol(symbol string) (*descriptorpb.FileDescriptorProto, error) {
+	return c.sendAndCacheFile(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: symbol},
+	})
+}
+
+// FileContainingExtension returns the descriptor for the file declaring
+// the extension identified by extendee and extNum.
+func (c *Client) FileContainingExtension(extendee string, extNum int32) (*descriptorpb.FileDescriptorProto, error) {
+	return c.sendAndCacheFile(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_FileContainingExtension{
+			FileContainingExtension: &rpb.ExtensionRequest{
+				ContainingType:  extendee,
+				ExtensionNumber: extNum,
+			},
+		},
+	})
+}
+
+// AllExtensionNumbersOfType returns the extension numbers registered
+// against the message with the given fully-qualified type name.
+func (c *Client) AllExtensionNumbersOfType(name string) ([]int32, error) {
+	resp, err := c.send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_AllExtensionNumbersOfType{AllExtensionNumbersOfType: name},
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := resp.GetAllExtensionNumbersResponse()
+	if r == nil {
+		return nil, fmt.Errorf("reflection: unexpected response type %T", resp.MessageResponse)
+	}
+	return r.ExtensionNumber, nil
+}
+
+// ListServices returns the full names of every service the server
+// advertises.
+func (c *Client) ListServices() ([]string, error) {
+	resp, err := c.send(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	r := resp.GetListServicesResponse()
+	if r == nil {
+		return nil, fmt.Errorf("reflection: unexpected response type %T", resp.MessageResponse)
+	}
+	names := make([]string, 0, len(r.Service))
+	for _, svc := range r.Service {
+		names = append(names, svc.Name)
+	}
+	return names, nil
+}
+
+// filesAsRegistry builds a protoregistry.Files containing root and every
+// file it transitively depends on, using descriptors already cached by a
+// prior FileByFilename/FileContainingSymbol/FileContainingExtension call.
+func (c *Client) filesAsRegistry(root *descriptorpb.FileDescriptorProto) (*protoregistry.Files, error) {
+	files := new(protoregistry.Files)
+	added := make(map[string]bool)
+
+	var add func(name string) error
+	add = func(name string) error {
+		if added[name] {
+			return nil
+		}
+		added[name] = true
+
+		fdProto := c.cachedFile(name)
+		if fdProto == nil {
+			return fmt.Errorf("reflection: no cached descriptor for dependency %q", name)
+		}
+		for _, dep := range fdProto.GetDependency() {
+			if err := add(dep); err != nil {
+				return err
+			}
+		}
+		fd, err := protodesc.NewFile(fdProto, files)
+		if err != nil {
+			return fmt.Errorf("reflection: building descriptor for %q: %v", name, err)
+		}
+		return files.RegisterFile(fd)
+	}
+
+	if err := add(root.GetName()); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// ResolveService fetches the descriptor for the named service and returns
+// it alongside a protoregistry.Files containing it and every file it
+// depends on, suitable for passing to dynamicpb.
+func (c *Client) ResolveService(name string) (protoreflect.ServiceDescriptor, *protoregistry.Files, error) {
+	fd, err := c.FileContainingSymbol(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	files, err := c.filesAsRegistry(fd)
+	if err != nil {
+		return nil, nil, err
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, nil, err
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("reflection: %q is a %T, not a service", name, d)
+	}
+	return sd, files, nil
+}
+
+// MessageType fetches the descriptor for the named message and returns a
+// dynamicpb-backed protoreflect.MessageType factory for it, resolving and
+// caching its file and transitive dependencies as needed.
+func (c *Client) MessageType(name string) (protoreflect.MessageType, error) {
+	fd, err := c.FileContainingSymbol(name)
+	if err != nil {
+		return nil, err
+	}
+	files, err := c.filesAsRegistry(fd)
+	if err != nil {
+		return nil, err
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(name))
+	if err != nil {
+		return nil, err
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("reflection: %q is a %T, not a message", name, d)
+	}
+	return dynamicpb.NewMessageType(md), nil
+}
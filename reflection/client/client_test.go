@@ -0,0 +1,134 @@
+/*
+ *
+ * Copyright 2022 gRPC authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package client_test
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/grpc/reflection/client"
+	pb "google.golang.org/grpc/reflection/grpc_testing"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+type searchServer struct{}
+
+func (searchServer) Search(ctx context.Context, in *pb.SearchRequest) (*pb.SearchResponse, error) {
+	return &pb.SearchResponse{
+		Results: []*pb.SearchResponse_Result{{Url: "https://example.com", Title: in.Query}},
+	}, nil
+}
+
+func (searchServer) StreamingSearch(stream pb.SearchService_StreamingSearchServer) error {
+	return nil
+}
+
+// startSearchServer starts a gRPC server exposing SearchService with
+// reflection installed, and returns a ClientConn dialed to it along with a
+// cleanup function.
+func startSearchServer(t *testing.T) (*grpc.ClientConn, func()) {
+	t.Helper()
+
+	lis, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	pb.RegisterSearchServiceServer(s, searchServer{})
+	reflection.InstallOnServer(s)
+	go s.Serve(lis)
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		lis.Close()
+		t.Fatalf("cannot connect to server: %v", err)
+	}
+	return conn, func() {
+		conn.Close()
+		s.Stop()
+	}
+}
+
+func TestClientResolveAndInvokeSearch(t *testing.T) {
+	conn, cleanup := startSearchServer(t)
+	defer cleanup()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, err := client.NewClient(ctx, conn)
+	if err != nil {
+		t.Fatalf("NewClient() = _, %v, want _, <nil>", err)
+	}
+	defer c.Close()
+
+	services, err := c.ListServices()
+	if err != nil {
+		t.Fatalf("ListServices() = _, %v, want _, <nil>", err)
+	}
+	found := false
+	for _, svc := range services {
+		if svc == "grpc.testing.SearchService" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("ListServices() = %v, want it to include grpc.testing.SearchService", services)
+	}
+
+	sd, _, err := c.ResolveService("grpc.testing.SearchService")
+	if err != nil {
+		t.Fatalf("ResolveService() = _, _, %v, want _, _, <nil>", err)
+	}
+	if got, want := string(sd.FullName()), "grpc.testing.SearchService"; got != want {
+		t.Fatalf("ResolveService() service = %q, want %q", got, want)
+	}
+
+	reqType, err := c.MessageType("grpc.testing.SearchRequest")
+	if err != nil {
+		t.Fatalf("MessageType(SearchRequest) = _, %v, want _, <nil>", err)
+	}
+	respType, err := c.MessageType("grpc.testing.SearchResponse")
+	if err != nil {
+		t.Fatalf("MessageType(SearchResponse) = _, %v, want _, <nil>", err)
+	}
+
+	req := reqType.New().Interface()
+	req.ProtoReflect().Set(
+		req.ProtoReflect().Descriptor().Fields().ByName("query"),
+		protoreflect.ValueOfString("hello reflection"),
+	)
+
+	resp := respType.New().Interface()
+	if err := conn.Invoke(ctx, "/grpc.testing.SearchService/Search", req, resp); err != nil {
+		t.Fatalf("Invoke(Search) = %v, want <nil>", err)
+	}
+
+	results := resp.ProtoReflect().Get(resp.ProtoReflect().Descriptor().Fields().ByName("results")).List()
+	if results.Len() != 1 {
+		t.Fatalf("got %d results, want 1", results.Len())
+	}
+	title := results.Get(0).Message().Get(results.Get(0).Message().Descriptor().Fields().ByName("title")).String()
+	if title != "hello reflection" {
+		t.Fatalf("results[0].title = %q, want %q", title, "hello reflection")
+	}
+}
@@ -0,0 +1,289 @@
+/*
+ *
+ * Copyright 2022 gRPC authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package reflection
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// extensionKey identifies an extension by the fully-qualified name of the
+// message it extends and its field number.
+type extensionKey struct {
+	containingType string
+	extNum         int32
+}
+
+// typeExtensionKey identifies an extension the way the legacy,
+// reflect.Type-based lookup path does.
+type typeExtensionKey struct {
+	st  reflect.Type
+	ext int32
+}
+
+// cachedFileDesc holds a decoded FileDescriptorProto alongside its
+// marshalled wire-format bytes, computed once no matter how many RPCs ask
+// for the same file.
+type cachedFileDesc struct {
+	fd  *dpb.FileDescriptorProto
+	enc []byte
+}
+
+// descCache memoizes the decoded and marshalled form of every file
+// descriptor a serverReflectionServer has served, and precomputes where to
+// find the file declaring a given symbol or extension, so
+// FileContainingSymbol and FileContainingExtension don't need to gunzip,
+// unmarshal and re-marshal a FileDescriptorProto on every RPC. It is built
+// once, in newDescCache, by walking every file known at construction time;
+// ServerOptions.CacheDisabled skips building one entirely for callers whose
+// registry can grow afterwards.
+type descCache struct {
+	mu  sync.Mutex
+	enc map[string]*cachedFileDesc
+
+	// symbolToFilename and extToFilename are built once, in newDescCache,
+	// and never mutated afterwards, so reading them needs no lock.
+	symbolToFilename map[string]string
+	extToFilename    map[extensionKey]string
+
+	// filesByPath backs the lazy population of enc for a file discovered
+	// via symbolToFilename, extToFilename or a direct filename lookup.
+	filesByPath map[string]protoreflect.FileDescriptor
+
+	// typeFileDesc and typeExtFileDesc memoize the legacy, reflect.Type
+	// based lookups performed by fileDescForType and
+	// fileDescContainingExtension.
+	typeFileDesc    map[reflect.Type]*dpb.FileDescriptorProto
+	typeExtFileDesc map[typeExtensionKey]*dpb.FileDescriptorProto
+}
+
+// newDescCache builds a descCache for svr by walking every file its
+// resolvers and explicit descriptors currently know about.
+func newDescCache(svr *serverReflectionServer) *descCache {
+	c := &descCache{
+		enc:              make(map[string]*cachedFileDesc),
+		symbolToFilename: make(map[string]string),
+		extToFilename:    make(map[extensionKey]string),
+		filesByPath:      make(map[string]protoreflect.FileDescriptor),
+		typeFileDesc:     make(map[reflect.Type]*dpb.FileDescriptorProto),
+		typeExtFileDesc:  make(map[typeExtensionKey]*dpb.FileDescriptorProto),
+	}
+
+	if svr.descriptorResolver != nil {
+		svr.descriptorResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+			c.indexFile(fd)
+			return true
+		})
+	}
+	for _, fd := range svr.files {
+		c.indexFile(fd)
+	}
+	for _, dp := range svr.descriptorProtos {
+		fd, err := protodesc.NewFile(dp, svr.descriptorResolver)
+		if err != nil {
+			// Best effort: a descriptor that can't be turned into a real
+			// protoreflect.FileDescriptor (e.g. a dependency isn't
+			// registered anywhere) just isn't indexed for O(1) lookup; it
+			// is still advertised by listServices via svr.descriptorProtos.
+			continue
+		}
+		c.indexFile(fd)
+	}
+	return c
+}
+
+// indexFile records fd's path for every symbol and extension it declares.
+func (c *descCache) indexFile(fd protoreflect.FileDescriptor) {
+	path := fd.Path()
+	if _, ok := c.filesByPath[path]; ok {
+		return
+	}
+	c.filesByPath[path] = fd
+	c.indexMessages(path, fd.Messages())
+	c.indexEnums(path, fd.Enums())
+	c.indexServices(path, fd.Services())
+	c.indexExtensions(path, fd.Extensions())
+}
+
+func (c *descCache) indexMessages(path string, msgs protoreflect.MessageDescriptors) {
+	for i := 0; i < msgs.Len(); i++ {
+		m := msgs.Get(i)
+		c.symbolToFilename[string(m.FullName())] = path
+		c.indexMessages(path, m.Messages())
+		c.indexEnums(path, m.Enums())
+		c.indexExtensions(path, m.Extensions())
+	}
+}
+
+func (c *descCache) indexEnums(path string, enums protoreflect.EnumDescriptors) {
+	for i := 0; i < enums.Len(); i++ {
+		c.symbolToFilename[string(enums.Get(i).FullName())] = path
+	}
+}
+
+func (c *descCache) indexServices(path string, svcs protoreflect.ServiceDescriptors) {
+	for i := 0; i < svcs.Len(); i++ {
+		c.symbolToFilename[string(svcs.Get(i).FullName())] = path
+	}
+}
+
+func (c *descCache) indexExtensions(path string, exts protoreflect.ExtensionDescriptors) {
+	for i := 0; i < exts.Len(); i++ {
+		e := exts.Get(i)
+		c.extToFilename[extensionKey{
+			containingType: string(e.ContainingMessage().FullName()),
+			extNum:         int32(e.Number()),
+		}] = path
+	}
+}
+
+// filenameForSymbol returns the file known to declare the given symbol via
+// the precomputed index, and whether one was found.
+func (c *descCache) filenameForSymbol(name string) (string, bool) {
+	path, ok := c.symbolToFilename[name]
+	return path, ok
+}
+
+// filenameForExtension returns the file known to declare the given
+// extension via the precomputed index, and whether one was found.
+func (c *descCache) filenameForExtension(containingType string, extNum int32) (string, bool) {
+	path, ok := c.extToFilename[extensionKey{containingType: containingType, extNum: extNum}]
+	return path, ok
+}
+
+// encoding returns the cached marshalled FileDescriptorProto for name,
+// decoding and marshalling it via load and caching the result the first
+// time name is requested.
+func (c *descCache) encoding(name string, load func() (*dpb.FileDescriptorProto, error)) ([]byte, error) {
+	c.mu.Lock()
+	if e, ok := c.enc[name]; ok {
+		c.mu.Unlock()
+		return e.enc, nil
+	}
+	c.mu.Unlock()
+
+	fd, err := load()
+	if err != nil {
+		return nil, err
+	}
+	enc, err := proto.Marshal(fd)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.enc[name] = &cachedFileDesc{fd: fd, enc: enc}
+	c.mu.Unlock()
+	return enc, nil
+}
+
+// encodingForPath returns the cached marshalled FileDescriptorProto for a
+// file discovered via the precomputed index, converting it from its
+// protoreflect.FileDescriptor the first time it's requested.
+func (c *descCache) encodingForPath(path string) ([]byte, error) {
+	return c.encoding(path, func() (*dpb.FileDescriptorProto, error) {
+		fd, ok := c.filesByPath[path]
+		if !ok {
+			return nil, fmt.Errorf("reflection: no descriptor known for %q", path)
+		}
+		return protodesc.ToFileDescriptorProto(fd), nil
+	})
+}
+
+// explicitDescCache indexes only svr.files and svr.descriptorProtos, i.e.
+// the descriptors ServerOptions passes explicitly rather than the ones
+// discovered through svr.descriptorResolver. It is never memoized on svr:
+// callers rebuild it on every lookup, which is the cost ServerOptions.
+// CacheDisabled accepts in exchange for tolerating that set growing after
+// NewServer returns. See (*serverReflectionServer).explicitFileByFilename
+// and friends, which exist so CacheDisabled only removes the O(1) lookup
+// newDescCache otherwise precomputes, not the ability to find these files
+// at all.
+func explicitDescCache(svr *serverReflectionServer) *descCache {
+	c := &descCache{
+		filesByPath:      make(map[string]protoreflect.FileDescriptor),
+		symbolToFilename: make(map[string]string),
+		extToFilename:    make(map[extensionKey]string),
+	}
+	for _, fd := range svr.files {
+		c.indexFile(fd)
+	}
+	for _, dp := range svr.descriptorProtos {
+		fd, err := protodesc.NewFile(dp, svr.descriptorResolver)
+		if err != nil {
+			// Best effort, same as newDescCache: a descriptor that can't be
+			// turned into a real protoreflect.FileDescriptor just isn't
+			// searchable here; it is still advertised by listServices via
+			// svr.descriptorProtos.
+			continue
+		}
+		c.indexFile(fd)
+	}
+	return c
+}
+
+// fileDescForType memoizes the legacy, reflect.Type based lookup performed
+// by serverReflectionServer.fileDescForType.
+func (c *descCache) fileDescForType(st reflect.Type, decode func() (*dpb.FileDescriptorProto, error)) (*dpb.FileDescriptorProto, error) {
+	c.mu.Lock()
+	if fd, ok := c.typeFileDesc[st]; ok {
+		c.mu.Unlock()
+		return fd, nil
+	}
+	c.mu.Unlock()
+
+	fd, err := decode()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.typeFileDesc[st] = fd
+	c.mu.Unlock()
+	return fd, nil
+}
+
+// fileDescContainingExtension memoizes the legacy, reflect.Type based
+// lookup performed by serverReflectionServer.fileDescContainingExtension.
+func (c *descCache) fileDescContainingExtension(st reflect.Type, ext int32, decode func() (*dpb.FileDescriptorProto, error)) (*dpb.FileDescriptorProto, error) {
+	key := typeExtensionKey{st: st, ext: ext}
+
+	c.mu.Lock()
+	if fd, ok := c.typeExtFileDesc[key]; ok {
+		c.mu.Unlock()
+		return fd, nil
+	}
+	c.mu.Unlock()
+
+	fd, err := decode()
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	c.typeExtFileDesc[key] = fd
+	c.mu.Unlock()
+	return fd, nil
+}
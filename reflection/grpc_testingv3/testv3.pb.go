@@ -0,0 +1,173 @@
+// Package grpc_testingv3 is a hand-maintained proto3 fixture, registered
+// only with the modern protoreflect/protoregistry machinery (not the
+// legacy github.com/golang/protobuf registry), used to exercise the
+// protoregistry-based descriptor lookup path in the reflection package.
+// It is not generated by protoc-gen-go; edit it directly.
+package grpc_testingv3
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protodesc "google.golang.org/protobuf/reflect/protodesc"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoregistry "google.golang.org/protobuf/reflect/protoregistry"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+)
+
+// SearchRequest is the request message for SearchServiceV3.Search.
+//
+// It is marshaled through the legacy github.com/golang/protobuf struct-tag
+// reflection rather than a generated ProtoReflect(), since this fixture
+// deliberately registers its descriptor only with protoregistry (see
+// init below) and not with the legacy registry.
+type SearchRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+
+func (m *SearchRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+// SearchResponse is the response message for SearchServiceV3.Search.
+type SearchResponse struct {
+	Results []*SearchResponse_Result `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+}
+
+func (m *SearchResponse) Reset()         { *m = SearchResponse{} }
+func (m *SearchResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse) ProtoMessage()    {}
+
+func (m *SearchResponse) GetResults() []*SearchResponse_Result {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+// SearchResponse_Result is a single search result.
+type SearchResponse_Result struct {
+	Url      string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Title    string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Snippets []string `protobuf:"bytes,3,rep,name=snippets,proto3" json:"snippets,omitempty"`
+}
+
+func (m *SearchResponse_Result) Reset()         { *m = SearchResponse_Result{} }
+func (m *SearchResponse_Result) String() string { return proto.CompactTextString(m) }
+func (*SearchResponse_Result) ProtoMessage()    {}
+
+func (m *SearchResponse_Result) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *SearchResponse_Result) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
+}
+
+func (m *SearchResponse_Result) GetSnippets() []string {
+	if m != nil {
+		return m.Snippets
+	}
+	return nil
+}
+
+// File_testv3_proto is the protoreflect.FileDescriptor for testv3.proto,
+// built from its descriptor proto and registered with the global registry
+// so it can be found via protoregistry.GlobalFiles without going through
+// the legacy github.com/golang/protobuf registry.
+var File_testv3_proto protoreflect.FileDescriptor
+
+func init() {
+	fd, err := protodesc.NewFile(fileDescriptorProto_testv3, protoregistry.GlobalFiles)
+	if err != nil {
+		panic(err)
+	}
+	if err := protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
+		panic(err)
+	}
+	File_testv3_proto = fd
+}
+
+var fileDescriptorProto_testv3 = &descriptorpb.FileDescriptorProto{
+	Name:    proto.String("testv3.proto"),
+	Package: proto.String("grpc.testingv3"),
+	Syntax:  proto.String("proto3"),
+	MessageType: []*descriptorpb.DescriptorProto{
+		{
+			Name: proto.String("SearchRequest"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("query"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					JsonName: proto.String("query"),
+				},
+			},
+		},
+		{
+			Name: proto.String("SearchResponse"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				{
+					Name:     proto.String("results"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".grpc.testingv3.SearchResponse.Result"),
+					JsonName: proto.String("results"),
+				},
+			},
+			NestedType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Result"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:     proto.String("url"),
+							Number:   proto.Int32(1),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+							JsonName: proto.String("url"),
+						},
+						{
+							Name:     proto.String("title"),
+							Number:   proto.Int32(2),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+							JsonName: proto.String("title"),
+						},
+						{
+							Name:     proto.String("snippets"),
+							Number:   proto.Int32(3),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+							JsonName: proto.String("snippets"),
+						},
+					},
+				},
+			},
+		},
+	},
+	Service: []*descriptorpb.ServiceDescriptorProto{
+		{
+			Name: proto.String("SearchServiceV3"),
+			Method: []*descriptorpb.MethodDescriptorProto{
+				{
+					Name:       proto.String("Search"),
+					InputType:  proto.String(".grpc.testingv3.SearchRequest"),
+					OutputType: proto.String(".grpc.testingv3.SearchResponse"),
+				},
+			},
+		},
+	},
+}
@@ -0,0 +1,88 @@
+// This file is a hand-maintained client/server stub for the grpc_testingv3
+// fixture, matching what protoc-gen-go-grpc would emit for testv3.proto.
+// It is not generated by any tool; edit it directly.
+
+package grpc_testingv3
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+const (
+	SearchServiceV3_Search_FullMethodName = "/grpc.testingv3.SearchServiceV3/Search"
+)
+
+// SearchServiceV3Client is the client API for SearchServiceV3 service.
+type SearchServiceV3Client interface {
+	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error)
+}
+
+type searchServiceV3Client struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSearchServiceV3Client(cc grpc.ClientConnInterface) SearchServiceV3Client {
+	return &searchServiceV3Client{cc}
+}
+
+func (c *searchServiceV3Client) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResponse, error) {
+	out := new(SearchResponse)
+	err := c.cc.Invoke(ctx, SearchServiceV3_Search_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SearchServiceV3Server is the server API for SearchServiceV3 service.
+type SearchServiceV3Server interface {
+	Search(context.Context, *SearchRequest) (*SearchResponse, error)
+}
+
+// UnimplementedSearchServiceV3Server can be embedded to have forward
+// compatible implementations.
+type UnimplementedSearchServiceV3Server struct{}
+
+func (UnimplementedSearchServiceV3Server) Search(context.Context, *SearchRequest) (*SearchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+
+func RegisterSearchServiceV3Server(s grpc.ServiceRegistrar, srv SearchServiceV3Server) {
+	s.RegisterService(&SearchServiceV3_ServiceDesc, srv)
+}
+
+func _SearchServiceV3_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServiceV3Server).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SearchServiceV3_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServiceV3Server).Search(ctx, req.(*SearchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// SearchServiceV3_ServiceDesc is the grpc.ServiceDesc for SearchServiceV3 service.
+var SearchServiceV3_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "grpc.testingv3.SearchServiceV3",
+	HandlerType: (*SearchServiceV3Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Search",
+			Handler:    _SearchServiceV3_Search_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "testv3.proto",
+}
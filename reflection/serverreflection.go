@@ -0,0 +1,581 @@
+/*
+ *
+ * Copyright 2016 gRPC authors.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+// Package reflection implements server reflection service.
+//
+// The services implemented are defined in:
+// https://github.com/grpc/grpc/blob/master/src/proto/grpc/reflection/v1/reflection.proto
+// and, for the deprecated v1alpha protocol,
+// https://github.com/grpc/grpc/blob/master/src/proto/grpc/reflection/v1alpha/reflection.proto.
+//
+// To register server reflection on a gRPC server:
+//
+//	import "google.golang.org/grpc/reflection"
+//
+//	s := grpc.NewServer()
+//	pb.RegisterYourOwnServer(s, &server{})
+//
+//	// Register reflection service on s.
+//	reflection.InstallOnServer(s)
+//
+//	s.Serve(lis)
+package reflection
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	v1pb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ExtensionResolver finds the extensions registered against a given message
+// type. protoregistry.GlobalTypes satisfies this interface.
+type ExtensionResolver interface {
+	protoregistry.ExtensionTypeResolver
+	RangeExtensionsByMessage(message protoreflect.FullName, f func(protoreflect.ExtensionType) bool)
+}
+
+// DescriptorResolver finds file descriptors by filename, and can enumerate
+// every file it knows about. protoregistry.GlobalFiles satisfies this
+// interface.
+type DescriptorResolver interface {
+	protodesc.Resolver
+	RangeFiles(f func(protoreflect.FileDescriptor) bool)
+}
+
+// ServerOptions configures a reflection server returned by NewServer.
+type ServerOptions struct {
+	// Server, if set, is introspected to list the services it has
+	// registered. The file descriptors of those services are looked up
+	// through DescriptorResolver and the legacy registry, not through Server.
+	Server *grpc.Server
+
+	// DescriptorProtos is an optional list of extra file descriptors, in
+	// addition to any found via Server, to advertise. Useful for services
+	// that are not registered on Server directly, e.g. those fronted by a
+	// gateway.
+	DescriptorProtos []*descriptorpb.FileDescriptorProto
+
+	// Files is like DescriptorProtos but expressed in terms of the
+	// google.golang.org/protobuf protoreflect API.
+	Files []protoreflect.FileDescriptor
+
+	// ExtensionResolver is used to look up the extensions registered
+	// against a given message type. Defaults to protoregistry.GlobalTypes.
+	ExtensionResolver ExtensionResolver
+
+	// DescriptorResolver is used to look up file descriptors by filename or
+	// by the symbols they contain. Defaults to protoregistry.GlobalFiles.
+	DescriptorResolver DescriptorResolver
+
+	// ServiceFilter, if set, is consulted by ListServices: a service is
+	// advertised only if this function returns true for its full name.
+	ServiceFilter func(name string) bool
+
+	// CacheDisabled disables the symbol and extension index NewServer
+	// otherwise builds eagerly from DescriptorResolver, Files and
+	// DescriptorProtos, and the descriptor cache it otherwise fills lazily. Set this if the registry backing those can gain
+	// services or files after NewServer returns and the server must see
+	// them without being reconstructed. Every lookup still falls back to
+	// searching Files and DescriptorProtos directly, so this only costs
+	// the O(1) lookup the cache would otherwise have provided, not the
+	// ability to find what they contain.
+	CacheDisabled bool
+
+	// DisableV1Alpha, when true, tells InstallOnServer to skip registering
+	// the deprecated grpc.reflection.v1alpha.ServerReflection service. The
+	// stable grpc.reflection.v1.ServerReflection service is always
+	// registered. NewServer ignores this field; it always returns a
+	// v1alpha implementation for InstallOnServer and the v1 adapter to share.
+	DisableV1Alpha bool
+}
+
+// serverReflectionServer implements ServerReflectionServer.
+type serverReflectionServer struct {
+	s                  *grpc.Server
+	descriptorProtos   []*descriptorpb.FileDescriptorProto
+	files              []protoreflect.FileDescriptor
+	extensionResolver  ExtensionResolver
+	descriptorResolver DescriptorResolver
+	serviceFilter      func(name string) bool
+
+	// cache is nil when ServerOptions.CacheDisabled is set.
+	cache *descCache
+}
+
+// protoMessage is used for type assertion on proto messages generated by
+// protoc-gen-go. Generated messages implement Descriptor(), which returns a
+// gzipped FileDescriptorProto and the index path of the message within it.
+type protoMessage interface {
+	Descriptor() ([]byte, []int)
+}
+
+// NewServer returns a reflection implementation configured by opts. The
+// returned value answers the grpc.reflection.v1alpha.ServerReflection RPCs;
+// InstallOnServer additionally wraps it to serve the stable v1 protocol.
+func NewServer(opts ServerOptions) rpb.ServerReflectionServer {
+	if opts.ExtensionResolver == nil {
+		opts.ExtensionResolver = protoregistry.GlobalTypes
+	}
+	if opts.DescriptorResolver == nil {
+		opts.DescriptorResolver = protoregistry.GlobalFiles
+	}
+	svr := &serverReflectionServer{
+		s:                  opts.Server,
+		descriptorProtos:   opts.DescriptorProtos,
+		files:              opts.Files,
+		extensionResolver:  opts.ExtensionResolver,
+		descriptorResolver: opts.DescriptorResolver,
+		serviceFilter:      opts.ServiceFilter,
+	}
+	if !opts.CacheDisabled {
+		svr.cache = newDescCache(svr)
+	}
+	return svr
+}
+
+// NewServerV1 returns a reflection implementation configured by opts that
+// answers the stable grpc.reflection.v1.ServerReflection RPCs. It wraps the
+// same v1alpha implementation NewServer returns, so a caller who wants to
+// register only the v1 protocol on their own server does not need to go
+// through InstallOnServer.
+func NewServerV1(opts ServerOptions) v1pb.ServerReflectionServer {
+	return &v1ServerAdapter{svr: NewServer(opts)}
+}
+
+// InstallOnServer registers the server reflection service(s) on s. By
+// default both the stable v1 protocol and the deprecated v1alpha protocol
+// are installed; pass a ServerOptions with DisableV1Alpha set to skip the
+// v1alpha registration. This is a thin, backwards-compatible wrapper
+// around NewServer for the common case where s is both the server to
+// introspect and the server to register on.
+func InstallOnServer(s *grpc.Server, opts ...ServerOptions) {
+	var opt ServerOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	opt.Server = s
+
+	svr := NewServer(opt)
+	v1pb.RegisterServerReflectionServer(s, &v1ServerAdapter{svr: svr})
+	if !opt.DisableV1Alpha {
+		rpb.RegisterServerReflectionServer(s, svr)
+	}
+}
+
+func (s *serverReflectionServer) decodeFileDesc(enc []byte) (*dpb.FileDescriptorProto, error) {
+	raw, err := decompress(enc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress enc: %v", err)
+	}
+
+	fd := new(dpb.FileDescriptorProto)
+	if err := proto.Unmarshal(raw, fd); err != nil {
+		return nil, fmt.Errorf("bad descriptor: %v", err)
+	}
+	return fd, nil
+}
+
+func decompress(b []byte) ([]byte, error) {
+	r, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %v", err)
+	}
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("bad gzipped descriptor: %v", err)
+	}
+	return out, nil
+}
+
+// explicitFileByFilename, explicitFileContainingSymbol and
+// explicitFileContainingExtension search svr.files and svr.descriptorProtos
+// directly. They back the fileDescEncoding* lookups below when s.cache is
+// nil, so ServerOptions.CacheDisabled only costs the precomputed index
+// newDescCache would otherwise have built, not the ability to find files
+// passed via ServerOptions.Files/DescriptorProtos that were never
+// registered with s.descriptorResolver.
+func (s *serverReflectionServer) explicitFileByFilename(name string) (protoreflect.FileDescriptor, bool) {
+	fd, ok := explicitDescCache(s).filesByPath[name]
+	return fd, ok
+}
+
+func (s *serverReflectionServer) explicitFileContainingSymbol(name string) (protoreflect.FileDescriptor, bool) {
+	c := explicitDescCache(s)
+	path, ok := c.filenameForSymbol(name)
+	if !ok {
+		return nil, false
+	}
+	return c.filesByPath[path], true
+}
+
+func (s *serverReflectionServer) explicitFileContainingExtension(containingType string, extNum int32) (protoreflect.FileDescriptor, bool) {
+	c := explicitDescCache(s)
+	path, ok := c.filenameForExtension(containingType, extNum)
+	if !ok {
+		return nil, false
+	}
+	return c.filesByPath[path], true
+}
+
+func (s *serverReflectionServer) fileDescForType(st reflect.Type) (*dpb.FileDescriptorProto, error) {
+	decode := func() (*dpb.FileDescriptorProto, error) {
+		m, ok := reflect.Zero(reflect.PtrTo(st)).Interface().(protoMessage)
+		if !ok {
+			return nil, fmt.Errorf("failed to create message from type: %v", st)
+		}
+		enc, _ := m.Descriptor()
+		return s.decodeFileDesc(enc)
+	}
+	if s.cache != nil {
+		return s.cache.fileDescForType(st, decode)
+	}
+	return decode()
+}
+
+func (s *serverReflectionServer) typeForName(name string) (reflect.Type, error) {
+	pt := proto.MessageType(name)
+	if pt == nil {
+		return nil, fmt.Errorf("unknown type: %q", name)
+	}
+	return pt.Elem(), nil
+}
+
+func (s *serverReflectionServer) fileDescContainingExtension(st reflect.Type, ext int32) (*dpb.FileDescriptorProto, error) {
+	decode := func() (*dpb.FileDescriptorProto, error) {
+		m, ok := reflect.Zero(reflect.PtrTo(st)).Interface().(proto.Message)
+		if !ok {
+			return nil, fmt.Errorf("failed to create message from type: %v", st)
+		}
+
+		var extDesc *proto.ExtensionDesc
+		for id, desc := range proto.RegisteredExtensions(m) {
+			if id == ext {
+				extDesc = desc
+				break
+			}
+		}
+		if extDesc == nil {
+			return nil, fmt.Errorf("failed to find registered extension for extension number %v", ext)
+		}
+
+		enc := proto.FileDescriptor(extDesc.Filename)
+		if enc == nil {
+			return nil, fmt.Errorf("failed to find file descriptor for %v", extDesc.Filename)
+		}
+		return s.decodeFileDesc(enc)
+	}
+	if s.cache != nil {
+		return s.cache.fileDescContainingExtension(st, ext, decode)
+	}
+	return decode()
+}
+
+func (s *serverReflectionServer) allExtensionNumbersForType(st reflect.Type) ([]int32, error) {
+	m, ok := reflect.Zero(reflect.PtrTo(st)).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("failed to create message from type: %v", st)
+	}
+	exts := proto.RegisteredExtensions(m)
+	out := make([]int32, 0, len(exts))
+	for id := range exts {
+		out = append(out, id)
+	}
+	sort.Sort(int32Slice(out))
+	return out, nil
+}
+
+type int32Slice []int32
+
+func (s int32Slice) Len() int           { return len(s) }
+func (s int32Slice) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s int32Slice) Less(i, j int) bool { return s[i] < s[j] }
+
+// marshalFileDescriptor marshals a protoreflect.FileDescriptor resolved via
+// the modern protobuf runtime into the wire format expected by reflection
+// clients.
+func marshalFileDescriptor(fd protoreflect.FileDescriptor) ([]byte, error) {
+	return proto.Marshal(protodesc.ToFileDescriptorProto(fd))
+}
+
+// fileDescEncodingByFilename returns the marshalled FileDescriptorProto for
+// the file with the given name. It first consults s.descriptorResolver
+// (protoregistry.GlobalFiles by default), which knows about files generated
+// with the modern google.golang.org/protobuf runtime, then falls back to
+// the legacy github.com/golang/protobuf registry. Unless s.cache is nil,
+// the result is decoded and marshalled only once per filename and shared
+// across every later request for it.
+func (s *serverReflectionServer) fileDescEncodingByFilename(name string) ([]byte, error) {
+	if s.cache != nil {
+		if _, ok := s.cache.filesByPath[name]; ok {
+			return s.cache.encodingForPath(name)
+		}
+	} else if fd, ok := s.explicitFileByFilename(name); ok {
+		return marshalFileDescriptor(fd)
+	}
+
+	load := func() (*dpb.FileDescriptorProto, error) {
+		if fd, err := s.descriptorResolver.FindFileByPath(name); err == nil {
+			return protodesc.ToFileDescriptorProto(fd), nil
+		}
+		enc := proto.FileDescriptor(name)
+		if enc == nil {
+			return nil, fmt.Errorf("unknown file: %v", name)
+		}
+		return s.decodeFileDesc(enc)
+	}
+	if s.cache != nil {
+		return s.cache.encoding(name, load)
+	}
+	fd, err := load()
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(fd)
+}
+
+// fileDescEncodingContainingSymbol returns the marshalled FileDescriptorProto
+// for the file declaring the given fully-qualified symbol. Unless s.cache
+// is nil, the precomputed symbol index answers this in O(1); otherwise (or
+// if the symbol was registered after the cache was built) it falls back to
+// s.descriptorResolver and then the legacy registry.
+func (s *serverReflectionServer) fileDescEncodingContainingSymbol(name string) ([]byte, error) {
+	if s.cache != nil {
+		if path, ok := s.cache.filenameForSymbol(name); ok {
+			return s.cache.encodingForPath(path)
+		}
+	} else if fd, ok := s.explicitFileContainingSymbol(name); ok {
+		return marshalFileDescriptor(fd)
+	}
+
+	if d, err := s.descriptorResolver.FindDescriptorByName(protoreflect.FullName(name)); err == nil {
+		fd := d.ParentFile()
+		if s.cache != nil {
+			return s.cache.encoding(fd.Path(), func() (*dpb.FileDescriptorProto, error) {
+				return protodesc.ToFileDescriptorProto(fd), nil
+			})
+		}
+		return marshalFileDescriptor(fd)
+	}
+
+	st, err := s.typeForName(name)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := s.fileDescForType(st)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(fd)
+}
+
+// fileDescEncodingContainingExtension returns the marshalled FileDescriptorProto
+// for the file that defines the given extension. Unless s.cache is nil,
+// the precomputed extension index answers this in O(1); otherwise (or if
+// the extension was registered after the cache was built) it falls back to
+// s.extensionResolver and then the legacy registry.
+func (s *serverReflectionServer) fileDescEncodingContainingExtension(typeName string, extNum int32) ([]byte, error) {
+	if s.cache != nil {
+		if path, ok := s.cache.filenameForExtension(typeName, extNum); ok {
+			return s.cache.encodingForPath(path)
+		}
+	} else if fd, ok := s.explicitFileContainingExtension(typeName, extNum); ok {
+		return marshalFileDescriptor(fd)
+	}
+
+	if et, err := s.extensionResolver.FindExtensionByNumber(protoreflect.FullName(typeName), protoreflect.FieldNumber(extNum)); err == nil {
+		fd := et.TypeDescriptor().ParentFile()
+		if s.cache != nil {
+			return s.cache.encoding(fd.Path(), func() (*dpb.FileDescriptorProto, error) {
+				return protodesc.ToFileDescriptorProto(fd), nil
+			})
+		}
+		return marshalFileDescriptor(fd)
+	}
+
+	st, err := s.typeForName(typeName)
+	if err != nil {
+		return nil, err
+	}
+	fd, err := s.fileDescContainingExtension(st, extNum)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(fd)
+}
+
+// allExtensionNumbersForTypeName returns the extension numbers registered
+// against the message with the given fully-qualified type name, merging
+// everything known to s.extensionResolver with whatever the legacy
+// registry reports for the same name.
+func (s *serverReflectionServer) allExtensionNumbersForTypeName(name string) ([]int32, error) {
+	nums := make(map[int32]struct{})
+	s.extensionResolver.RangeExtensionsByMessage(protoreflect.FullName(name), func(et protoreflect.ExtensionType) bool {
+		nums[int32(et.TypeDescriptor().Number())] = struct{}{}
+		return true
+	})
+
+	if st, err := s.typeForName(name); err == nil {
+		if legacyNums, err := s.allExtensionNumbersForType(st); err == nil {
+			for _, n := range legacyNums {
+				nums[n] = struct{}{}
+			}
+		}
+	}
+
+	if len(nums) == 0 {
+		return nil, fmt.Errorf("unknown type: %q", name)
+	}
+	out := make([]int32, 0, len(nums))
+	for n := range nums {
+		out = append(out, n)
+	}
+	sort.Sort(int32Slice(out))
+	return out, nil
+}
+
+// listServices returns the full names of all services registered on s.s
+// plus those contributed by s.descriptorProtos and s.files, filtered
+// through s.serviceFilter if one is set.
+func (s *serverReflectionServer) listServices() []*rpb.ServiceResponse {
+	names := make(map[string]struct{})
+	if s.s != nil {
+		for svc := range s.s.GetServiceInfo() {
+			names[svc] = struct{}{}
+		}
+	}
+	for _, fd := range s.descriptorProtos {
+		pkg := fd.GetPackage()
+		for _, svc := range fd.GetService() {
+			names[qualify(pkg, svc.GetName())] = struct{}{}
+		}
+	}
+	for _, fd := range s.files {
+		svcs := fd.Services()
+		for i := 0; i < svcs.Len(); i++ {
+			names[string(svcs.Get(i).FullName())] = struct{}{}
+		}
+	}
+
+	resp := make([]*rpb.ServiceResponse, 0, len(names))
+	for name := range names {
+		if s.serviceFilter != nil && !s.serviceFilter(name) {
+			continue
+		}
+		resp = append(resp, &rpb.ServiceResponse{Name: name})
+	}
+	return resp
+}
+
+// qualify joins a proto package name and a bare service name into a
+// fully-qualified service name.
+func qualify(pkg, name string) string {
+	if pkg == "" {
+		return name
+	}
+	return pkg + "." + name
+}
+
+// ServerReflectionInfo implements ServerReflectionServer.
+func (s *serverReflectionServer) ServerReflectionInfo(stream rpb.ServerReflection_ServerReflectionInfoServer) error {
+	for {
+		in, err := stream.Recv()
+		if err != nil {
+			return err
+		}
+
+		out := &rpb.ServerReflectionResponse{
+			ValidHost:       in.Host,
+			OriginalRequest: in,
+		}
+		switch req := in.MessageRequest.(type) {
+		case *rpb.ServerReflectionRequest_FileByFilename:
+			b, err := s.fileDescEncodingByFilename(req.FileByFilename)
+			if err != nil {
+				out.MessageResponse = newErrorResponse(err)
+			} else {
+				out.MessageResponse = newFileDescriptorResponse(b)
+			}
+		case *rpb.ServerReflectionRequest_FileContainingSymbol:
+			b, err := s.fileDescEncodingContainingSymbol(req.FileContainingSymbol)
+			if err != nil {
+				out.MessageResponse = newErrorResponse(err)
+			} else {
+				out.MessageResponse = newFileDescriptorResponse(b)
+			}
+		case *rpb.ServerReflectionRequest_FileContainingExtension:
+			b, err := s.fileDescEncodingContainingExtension(req.FileContainingExtension.ContainingType, req.FileContainingExtension.ExtensionNumber)
+			if err != nil {
+				out.MessageResponse = newErrorResponse(err)
+			} else {
+				out.MessageResponse = newFileDescriptorResponse(b)
+			}
+		case *rpb.ServerReflectionRequest_AllExtensionNumbersOfType:
+			extNums, err := s.allExtensionNumbersForTypeName(req.AllExtensionNumbersOfType)
+			if err != nil {
+				out.MessageResponse = newErrorResponse(err)
+			} else {
+				out.MessageResponse = &rpb.ServerReflectionResponse_AllExtensionNumbersResponse{
+					AllExtensionNumbersResponse: &rpb.ExtensionNumberResponse{
+						BaseTypeName:    req.AllExtensionNumbersOfType,
+						ExtensionNumber: extNums,
+					},
+				}
+			}
+		case *rpb.ServerReflectionRequest_ListServices:
+			out.MessageResponse = &rpb.ServerReflectionResponse_ListServicesResponse{
+				ListServicesResponse: &rpb.ListServiceResponse{Service: s.listServices()},
+			}
+		default:
+			return status.Errorf(codes.InvalidArgument, "invalid MessageRequest: %v", in.MessageRequest)
+		}
+
+		if err := stream.Send(out); err != nil {
+			return err
+		}
+	}
+}
+
+func newFileDescriptorResponse(b []byte) *rpb.ServerReflectionResponse_FileDescriptorResponse {
+	return &rpb.ServerReflectionResponse_FileDescriptorResponse{
+		FileDescriptorResponse: &rpb.FileDescriptorResponse{FileDescriptorProto: [][]byte{b}},
+	}
+}
+
+func newErrorResponse(err error) *rpb.ServerReflectionResponse_ErrorResponse {
+	return &rpb.ServerReflectionResponse_ErrorResponse{
+		ErrorResponse: &rpb.ErrorResponse{
+			ErrorCode:    int32(codes.NotFound),
+			ErrorMessage: err.Error(),
+		},
+	}
+}
@@ -11,8 +11,14 @@ import (
 	dpb "github.com/golang/protobuf/protoc-gen-go/descriptor"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	v1pb "google.golang.org/grpc/reflection/grpc_reflection_v1"
 	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	pb "google.golang.org/grpc/reflection/grpc_testing"
+	pbv3 "google.golang.org/grpc/reflection/grpc_testingv3"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 var (
@@ -58,7 +64,7 @@ func TestFileDescForType(t *testing.T) {
 		{reflect.TypeOf(pb.ToBeExtened{}), fdProto2},
 	} {
 		fd, err := s.fileDescForType(test.st)
-		if err != nil || !reflect.DeepEqual(fd, test.wantFd) {
+		if err != nil || !proto.Equal(fd, test.wantFd) {
 			t.Fatalf("fileDescForType(%q) = %q, %v, want %q, <nil>", test.st, fd, err, test.wantFd)
 		}
 	}
@@ -98,7 +104,7 @@ func TestFileDescContainingExtension(t *testing.T) {
 		{reflect.TypeOf(pb.ToBeExtened{}), 17, fdProto2Ext},
 	} {
 		fd, err := s.fileDescContainingExtension(test.st, test.extNum)
-		if err != nil || !reflect.DeepEqual(fd, test.want) {
+		if err != nil || !proto.Equal(fd, test.want) {
 			t.Fatalf("fileDescContainingExtension(%q) = %q, %v, want %q, <nil>", test.st, fd, err, test.want)
 		}
 	}
@@ -116,7 +122,7 @@ func TestAllExtensionNumbersForType(t *testing.T) {
 		st   reflect.Type
 		want []int32
 	}{
-		{reflect.TypeOf(pb.ToBeExtened{}), []int32{13, 17}},
+		{reflect.TypeOf(pb.ToBeExtened{}), []int32{13, 17, 19, 23}},
 	} {
 		r, err := s.allExtensionNumbersForType(test.st)
 		sort.Sort(intArray(r))
@@ -163,17 +169,98 @@ func TestEnd2end(t *testing.T) {
 
 	c := rpb.NewServerReflectionClient(conn)
 	stream, err := c.ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("cannot get ServerReflectionInfo: %v", err)
+	}
+	runEnd2endTests(t, stream)
+
+	c1 := v1pb.NewServerReflectionClient(conn)
+	stream1, err := c1.ServerReflectionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("cannot get ServerReflectionInfo: %v", err)
+	}
+	runEnd2endTests(t, &v1ClientStreamAdapter{stream1})
+
+	s.Stop()
+}
+
+func TestInstallOnServerWithV1AlphaDisabled(t *testing.T) {
+	s := grpc.NewServer()
+	InstallOnServer(s, ServerOptions{DisableV1Alpha: true})
+
+	serviceInfo := s.GetServiceInfo()
+	if _, ok := serviceInfo["grpc.reflection.v1.ServerReflection"]; !ok {
+		t.Errorf("expected grpc.reflection.v1.ServerReflection to be registered")
+	}
+	if _, ok := serviceInfo["grpc.reflection.v1alpha.ServerReflection"]; ok {
+		t.Errorf("expected grpc.reflection.v1alpha.ServerReflection to not be registered")
+	}
+}
+
+func TestNewServerWithExtraDescriptorsAndServiceFilter(t *testing.T) {
+	gs := grpc.NewServer()
+	pb.RegisterSearchServiceServer(gs, &server{})
+
+	svr := NewServer(ServerOptions{
+		Server: gs,
+		DescriptorProtos: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:    proto.String("gateway.proto"),
+				Package: proto.String("grpc.testing.gateway"),
+				Service: []*descriptorpb.ServiceDescriptorProto{{Name: proto.String("GatewayService")}},
+			},
+		},
+		ServiceFilter: func(name string) bool { return name != "grpc.testing.SearchService" },
+	}).(*serverReflectionServer)
+
+	got := make(map[string]bool)
+	for _, svc := range svr.listServices() {
+		got[svc.Name] = true
+	}
+	if got["grpc.testing.SearchService"] {
+		t.Errorf("listServices() included grpc.testing.SearchService, want it filtered out")
+	}
+	if !got["grpc.testing.gateway.GatewayService"] {
+		t.Errorf("listServices() = %v, want it to include grpc.testing.gateway.GatewayService", got)
+	}
+}
 
+// reflectionInfoStream is the subset of
+// rpb.ServerReflection_ServerReflectionInfoClient used by the subtests
+// below, so they can be run against either the v1alpha or the v1 stub.
+type reflectionInfoStream interface {
+	Send(*rpb.ServerReflectionRequest) error
+	Recv() (*rpb.ServerReflectionResponse, error)
+}
+
+// v1ClientStreamAdapter adapts a v1 ServerReflectionInfo client stream to
+// reflectionInfoStream, translating requests and responses through the
+// same adapter used to serve the v1 protocol.
+type v1ClientStreamAdapter struct {
+	stream v1pb.ServerReflection_ServerReflectionInfoClient
+}
+
+func (a *v1ClientStreamAdapter) Send(req *rpb.ServerReflectionRequest) error {
+	return a.stream.Send(V1AlphaRequestToV1(req))
+}
+
+func (a *v1ClientStreamAdapter) Recv() (*rpb.ServerReflectionResponse, error) {
+	resp, err := a.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return V1ResponseToV1Alpha(resp), nil
+}
+
+func runEnd2endTests(t *testing.T, stream reflectionInfoStream) {
 	testFileByFilename(t, stream)
 	testFileContainingSymbol(t, stream)
 	testFileContainingExtension(t, stream)
 	testAllExtensionNumbersOfType(t, stream)
 	testListServices(t, stream)
-
-	s.Stop()
 }
 
-func testFileByFilename(t *testing.T, stream rpb.ServerReflection_ServerReflectionInfoClient) {
+func testFileByFilename(t *testing.T, stream reflectionInfoStream) {
 	for _, test := range []struct {
 		filename string
 		want     []byte
@@ -206,7 +293,7 @@ func testFileByFilename(t *testing.T, stream rpb.ServerReflection_ServerReflecti
 	}
 }
 
-func testFileContainingSymbol(t *testing.T, stream rpb.ServerReflection_ServerReflectionInfoClient) {
+func testFileContainingSymbol(t *testing.T, stream reflectionInfoStream) {
 	for _, test := range []struct {
 		symbol string
 		want   []byte
@@ -240,7 +327,7 @@ func testFileContainingSymbol(t *testing.T, stream rpb.ServerReflection_ServerRe
 	}
 }
 
-func testFileContainingExtension(t *testing.T, stream rpb.ServerReflection_ServerReflectionInfoClient) {
+func testFileContainingExtension(t *testing.T, stream reflectionInfoStream) {
 	for _, test := range []struct {
 		typeName string
 		extNum   int32
@@ -275,12 +362,12 @@ func testFileContainingExtension(t *testing.T, stream rpb.ServerReflection_Serve
 	}
 }
 
-func testAllExtensionNumbersOfType(t *testing.T, stream rpb.ServerReflection_ServerReflectionInfoClient) {
+func testAllExtensionNumbersOfType(t *testing.T, stream reflectionInfoStream) {
 	for _, test := range []struct {
 		typeName string
 		want     []int32
 	}{
-		{"grpc.testing.ToBeExtened", []int32{13, 17}},
+		{"grpc.testing.ToBeExtened", []int32{13, 17, 19, 23}},
 	} {
 		if err := stream.Send(&rpb.ServerReflectionRequest{
 			MessageRequest: &rpb.ServerReflectionRequest_AllExtensionNumbersOfType{
@@ -309,7 +396,7 @@ func testAllExtensionNumbersOfType(t *testing.T, stream rpb.ServerReflection_Ser
 	}
 }
 
-func testListServices(t *testing.T, stream rpb.ServerReflection_ServerReflectionInfoClient) {
+func testListServices(t *testing.T, stream reflectionInfoStream) {
 	if err := stream.Send(&rpb.ServerReflectionRequest{
 		MessageRequest: &rpb.ServerReflectionRequest_ListServices{},
 	}); err != nil {
@@ -324,7 +411,7 @@ func testListServices(t *testing.T, stream rpb.ServerReflection_ServerReflection
 	switch r.MessageResponse.(type) {
 	case *rpb.ServerReflectionResponse_ListServicesResponse:
 		services := r.GetListServicesResponse().Service
-		want := []string{"grpc.testing.SearchService", "grpc.reflection.v1alpha.ServerReflection"}
+		want := []string{"grpc.testing.SearchService", "grpc.reflection.v1.ServerReflection", "grpc.reflection.v1alpha.ServerReflection"}
 		// Compare service names in response with want.
 		if len(services) != len(want) {
 			t.Fatalf("= %v, want service names: %v", services, want)
@@ -344,3 +431,161 @@ func testListServices(t *testing.T, stream rpb.ServerReflection_ServerReflection
 		t.Fatalf("ListServices = %v, want type <ServerReflectionResponse_ListServicesResponse>", r.MessageResponse)
 	}
 }
+
+// searchV3Server is a minimal grpc_testingv3.SearchServiceV3Server used to
+// verify that the reflection server can resolve a service's descriptor
+// purely through the protoregistry-based lookup path, i.e. without the
+// service ever touching the legacy github.com/golang/protobuf registry.
+type searchV3Server struct {
+	pbv3.UnimplementedSearchServiceV3Server
+}
+
+func TestNewServerDescriptorViaProtoregistry(t *testing.T) {
+	gs := grpc.NewServer()
+	pbv3.RegisterSearchServiceV3Server(gs, &searchV3Server{})
+
+	svr := NewServer(ServerOptions{Server: gs}).(*serverReflectionServer)
+
+	if _, err := svr.fileDescEncodingByFilename("testv3.proto"); err != nil {
+		t.Fatalf("fileDescEncodingByFilename(%q) = _, %v, want _, <nil>", "testv3.proto", err)
+	}
+	if _, err := svr.fileDescEncodingContainingSymbol("grpc.testingv3.SearchServiceV3"); err != nil {
+		t.Fatalf("fileDescEncodingContainingSymbol(%q) = _, %v, want _, <nil>", "grpc.testingv3.SearchServiceV3", err)
+	}
+
+	found := false
+	for _, svc := range svr.listServices() {
+		if svc.Name == "grpc.testingv3.SearchServiceV3" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("listServices() did not include grpc.testingv3.SearchServiceV3")
+	}
+}
+
+func TestServerOptionsCacheDisabled(t *testing.T) {
+	gs := grpc.NewServer()
+	pb.RegisterSearchServiceServer(gs, &server{})
+
+	svr := NewServer(ServerOptions{Server: gs, CacheDisabled: true}).(*serverReflectionServer)
+	if svr.cache != nil {
+		t.Fatalf("cache = %v, want nil when CacheDisabled is set", svr.cache)
+	}
+	if _, err := svr.fileDescEncodingContainingSymbol("grpc.testing.SearchResponse"); err != nil {
+		t.Fatalf("fileDescEncodingContainingSymbol() = _, %v, want _, <nil>", err)
+	}
+}
+
+func TestServerOptionsCacheDisabledFindsExplicitDescriptorProtos(t *testing.T) {
+	gs := grpc.NewServer()
+
+	svr := NewServer(ServerOptions{
+		Server:        gs,
+		CacheDisabled: true,
+		DescriptorProtos: []*descriptorpb.FileDescriptorProto{
+			{
+				Name:    proto.String("gateway.proto"),
+				Package: proto.String("grpc.testing.gateway"),
+				Service: []*descriptorpb.ServiceDescriptorProto{{Name: proto.String("GatewayService")}},
+			},
+		},
+	}).(*serverReflectionServer)
+	if svr.cache != nil {
+		t.Fatalf("cache = %v, want nil when CacheDisabled is set", svr.cache)
+	}
+
+	if _, err := svr.fileDescEncodingByFilename("gateway.proto"); err != nil {
+		t.Fatalf("fileDescEncodingByFilename(%q) = _, %v, want _, <nil>", "gateway.proto", err)
+	}
+	if _, err := svr.fileDescEncodingContainingSymbol("grpc.testing.gateway.GatewayService"); err != nil {
+		t.Fatalf("fileDescEncodingContainingSymbol(%q) = _, %v, want _, <nil>", "grpc.testing.gateway.GatewayService", err)
+	}
+}
+
+func TestServerCachePrecomputesSymbolIndex(t *testing.T) {
+	gs := grpc.NewServer()
+	pb.RegisterSearchServiceServer(gs, &server{})
+
+	svr := NewServer(ServerOptions{Server: gs}).(*serverReflectionServer)
+	if svr.cache == nil {
+		t.Fatalf("cache = nil, want non-nil by default")
+	}
+	// grpc_testingv3 registers its file with protoregistry.GlobalFiles on
+	// import, so the default DescriptorResolver finds it without ever
+	// being registered on gs.
+	if _, ok := svr.cache.filenameForSymbol("grpc.testingv3.SearchServiceV3"); !ok {
+		t.Fatalf("filenameForSymbol(%q) = _, false, want _, true", "grpc.testingv3.SearchServiceV3")
+	}
+
+	b1, err := svr.fileDescEncodingContainingSymbol("grpc.testingv3.SearchServiceV3")
+	if err != nil {
+		t.Fatalf("fileDescEncodingContainingSymbol() = _, %v, want _, <nil>", err)
+	}
+	b2, err := svr.fileDescEncodingContainingSymbol("grpc.testingv3.SearchServiceV3")
+	if err != nil {
+		t.Fatalf("fileDescEncodingContainingSymbol() = _, %v, want _, <nil>", err)
+	}
+	if string(b1) != string(b2) {
+		t.Fatalf("fileDescEncodingContainingSymbol() returned different bytes across calls")
+	}
+}
+
+// buildBenchFiles returns n distinct, unregistered file descriptors, each
+// declaring one message and one service, for use as ServerOptions.Files in
+// BenchmarkFileContainingSymbol.
+func buildBenchFiles(n int) []protoreflect.FileDescriptor {
+	reg := new(protoregistry.Files)
+	files := make([]protoreflect.FileDescriptor, 0, n)
+	for i := 0; i < n; i++ {
+		dp := &descriptorpb.FileDescriptorProto{
+			Name:    proto.String(fmt.Sprintf("bench%d.proto", i)),
+			Package: proto.String(fmt.Sprintf("grpc.testing.bench.f%d", i)),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name: proto.String("Msg"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						{
+							Name:     proto.String("value"),
+							Number:   proto.Int32(1),
+							Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+							Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+							JsonName: proto.String("value"),
+						},
+					},
+				},
+			},
+			Service: []*descriptorpb.ServiceDescriptorProto{
+				{Name: proto.String("Service")},
+			},
+		}
+		fd, err := protodesc.NewFile(dp, reg)
+		if err != nil {
+			panic(fmt.Sprintf("failed to build bench file descriptor: %v", err))
+		}
+		if err := reg.RegisterFile(fd); err != nil {
+			panic(fmt.Sprintf("failed to register bench file descriptor: %v", err))
+		}
+		files = append(files, fd)
+	}
+	return files
+}
+
+// BenchmarkFileContainingSymbol issues 10k FileContainingSymbol lookups
+// against a server backed by a few hundred files, to measure the effect of
+// the precomputed symbol index and the marshalled-descriptor cache.
+func BenchmarkFileContainingSymbol(b *testing.B) {
+	const numFiles = 300
+	svr := NewServer(ServerOptions{Files: buildBenchFiles(numFiles)}).(*serverReflectionServer)
+	symbol := fmt.Sprintf("grpc.testing.bench.f%d.Msg", numFiles/2)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j := 0; j < 10000; j++ {
+			if _, err := svr.fileDescEncodingContainingSymbol(symbol); err != nil {
+				b.Fatalf("fileDescEncodingContainingSymbol(%q) = _, %v, want _, <nil>", symbol, err)
+			}
+		}
+	}
+}